@@ -0,0 +1,73 @@
+import { render, screen } from '@testing-library/react';
+
+import { Sidebar } from '../../components/Sidebar';
+import { SocialLink, validateSocialLinks } from '../../config/social';
+
+const mockedLinks: SocialLink[] = [
+  {
+    name: 'Mastodon',
+    url: 'https://mastodon.social/@p_vcent',
+    icon: 'mastodon',
+    rel: ['me'],
+  },
+  {
+    name: 'Linkedin',
+    url: 'https://www.linkedin.com/in/p_vcent/',
+    icon: 'linkedin',
+  },
+];
+
+describe('Sidebar', () => {
+  it('should be able to render social links in the configured order', () => {
+    render(<Sidebar links={mockedLinks} />);
+
+    const links = screen.getAllByRole('link');
+
+    expect(links).toHaveLength(2);
+    expect(links[0]).toHaveAttribute('href', mockedLinks[0].url);
+    expect(links[1]).toHaveAttribute('href', mockedLinks[1].url);
+  });
+
+  it('should be able to emit rel="me" alongside noreferrer', () => {
+    render(<Sidebar links={mockedLinks} />);
+
+    const mastodonRel = screen
+      .getByLabelText('Mastodon')
+      .getAttribute('rel')
+      .split(' ');
+
+    expect(mastodonRel).toEqual(expect.arrayContaining(['me', 'noreferrer']));
+    expect(screen.getByLabelText('Linkedin')).toHaveAttribute(
+      'rel',
+      'noreferrer'
+    );
+  });
+
+  it('should be able to render the configured social links', () => {
+    render(<Sidebar />);
+
+    screen.getByLabelText('Github');
+    screen.getByLabelText('Instagram');
+  });
+
+  it('should not be able to configure a non http(s) link', () => {
+    const urls = [
+      // eslint-disable-next-line no-script-url
+      'javascript:alert(1)',
+      'mailto:me@example.com',
+      'ftp://example.com',
+    ];
+
+    urls.forEach(url => {
+      expect(() =>
+        validateSocialLinks([{ name: 'Evil', url, icon: 'github' }])
+      ).toThrow('Social link "Evil" must use an absolute http(s) URL');
+    });
+  });
+
+  it('should not be able to configure a relative link', () => {
+    expect(() =>
+      validateSocialLinks([{ name: 'About', url: '/about', icon: 'github' }])
+    ).toThrow('Social link "About" has an invalid URL');
+  });
+});
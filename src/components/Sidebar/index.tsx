@@ -1,47 +1,43 @@
 /* eslint-disable @typescript-eslint/explicit-function-return-type */
+import { IconType } from 'react-icons';
+import { FaMastodon } from 'react-icons/fa';
 import { FiGithub, FiInstagram, FiLinkedin, FiTwitter } from 'react-icons/fi';
+import { SocialIcon, SocialLink, socialLinks } from '../../config/social';
 import styles from './styles.module.scss';
 
-export const Sidebar = (): JSX.Element => {
+const icons: Record<SocialIcon, IconType> = {
+  github: FiGithub,
+  twitter: FiTwitter,
+  linkedin: FiLinkedin,
+  instagram: FiInstagram,
+  mastodon: FaMastodon,
+};
+
+interface SidebarProps {
+  links?: SocialLink[];
+}
+
+export const Sidebar = ({ links = socialLinks }: SidebarProps): JSX.Element => {
   return (
     <aside className={styles.aside}>
       <ul>
-        <li className="">
-          <a href="https://github.com/0xb0b1" target="_blank" rel="noreferrer">
-            <FiGithub size={22} />
-          </a>
-          {/* <span className="">Github</span> */}
-        </li>
-        <li>
-          <a
-            href="https://twitter.com/p_vcent"
-            target="_blank"
-            rel="noreferrer"
-          >
-            <FiTwitter size={22} />
-          </a>
-          {/* <span>Twitter</span> */}
-        </li>
-        <li>
-          <a
-            href="https://www.linkedin.com/in/paulo-vicente-6abab0198/"
-            target="_blank"
-            rel="noreferrer"
-          >
-            <FiLinkedin size={22} />
-          </a>
-          {/* <span>Linkedin</span> */}
-        </li>
-        <li>
-          <a
-            href="https://www.instagram.com/p_vcent/"
-            target="_blank"
-            rel="noreferrer"
-          >
-            <FiInstagram size={22} />
-          </a>
-          {/* <span>Instagram</span> */}
-        </li>
+        {links.map(link => {
+          const Icon = icons[link.icon];
+          const extraRel = link.rel?.length ? ` ${link.rel.join(' ')}` : '';
+
+          return (
+            <li key={link.url}>
+              <a
+                href={link.url}
+                target="_blank"
+                rel={`noreferrer${extraRel}`}
+                aria-label={link.name}
+              >
+                <Icon size={22} />
+              </a>
+            </li>
+          );
+        })}
       </ul>
     </aside>
   );
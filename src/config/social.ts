@@ -0,0 +1,62 @@
+export type SocialIcon =
+  | 'github'
+  | 'twitter'
+  | 'linkedin'
+  | 'instagram'
+  | 'mastodon';
+
+export interface SocialLink {
+  name: string;
+  url: string;
+  icon: SocialIcon;
+  rel?: string[];
+}
+
+export function validateSocialLinks(links: SocialLink[]): SocialLink[] {
+  links.forEach(link => {
+    let protocol: string;
+
+    try {
+      protocol = new URL(link.url).protocol;
+    } catch {
+      throw new Error(`Social link "${link.name}" has an invalid URL`);
+    }
+
+    if (protocol !== 'http:' && protocol !== 'https:') {
+      throw new Error(
+        `Social link "${link.name}" must use an absolute http(s) URL`
+      );
+    }
+  });
+
+  return links;
+}
+
+// Sidebar anchors always get rel="noreferrer"; rel here adds extra values,
+// e.g. "me" so profile sites (Mastodon) can verify the link back to them.
+export const socialLinks = validateSocialLinks([
+  {
+    name: 'Github',
+    url: 'https://github.com/0xb0b1',
+    icon: 'github',
+    rel: ['me'],
+  },
+  {
+    name: 'Twitter',
+    url: 'https://twitter.com/p_vcent',
+    icon: 'twitter',
+    rel: ['me'],
+  },
+  {
+    name: 'Linkedin',
+    url: 'https://www.linkedin.com/in/paulo-vicente-6abab0198/',
+    icon: 'linkedin',
+    rel: ['me'],
+  },
+  {
+    name: 'Instagram',
+    url: 'https://www.instagram.com/p_vcent/',
+    icon: 'instagram',
+    rel: ['me'],
+  },
+]);